@@ -0,0 +1,242 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BakedIn is a standard set of parameterized validators: min, max, len, gt,
+// lt, oneof and regex, plus required, which takes no parameter. Callers may
+// copy its entries into a V's Funcs, or add further entries alongside it.
+//
+//	vd := validate.New()
+//	for name, fn := range validate.BakedIn {
+//		vd.Funcs[name] = fn
+//	}
+var BakedIn = map[string]Func{
+	"min":      min,
+	"max":      max,
+	"len":      length,
+	"gt":       gt,
+	"lt":       lt,
+	"oneof":    oneof,
+	"regex":    regex,
+	"required": required,
+}
+
+// BakedInCross is a standard set of cross-field validators: eqfield,
+// eqtopfield and gtefield. Callers may copy its entries into a V's Cross the
+// same way BakedIn is copied into Funcs.
+var BakedInCross = map[string]CrossFunc{
+	"eqfield":    eqfield,
+	"eqtopfield": eqtopfield,
+	"gtefield":   gtefield,
+}
+
+// defaultEnglishTranslations is the English message catalog for BakedIn and
+// BakedInCross, registered by RegisterDefaultTranslations.
+var defaultEnglishTranslations = map[string]string{
+	"min":        "{0} must be at least {1}",
+	"max":        "{0} must be at most {1}",
+	"len":        "{0} must have length {1}",
+	"gt":         "{0} must be greater than {1}",
+	"lt":         "{0} must be less than {1}",
+	"oneof":      "{0} must be one of [{1}]",
+	"regex":      "{0} must match {1}",
+	"eqfield":    "{0} must equal field {1}",
+	"eqtopfield": "{0} must equal field {1}",
+	"gtefield":   "{0} must be greater than or equal to field {1}",
+	"required":   "{0} is required",
+}
+
+// RegisterDefaultTranslations registers English message templates for every
+// validator in BakedIn and BakedInCross with t. It does not register the
+// validators themselves with a V; see BakedIn and BakedInCross for that.
+func RegisterDefaultTranslations(t *Translator) {
+	for tag, template := range defaultEnglishTranslations {
+		t.Add(tag, template)
+	}
+}
+
+// numericLen returns the length used to compare i against a numeric
+// parameter: the value itself for numbers, and the length for strings,
+// slices, arrays and maps.
+func numericLen(i interface{}) (float64, bool) {
+	v := reflect.ValueOf(i)
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+func min(i interface{}, param string) error {
+	n, ok := numericLen(i)
+	if !ok {
+		return fmt.Errorf("min: unsupported type %T", i)
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("min: invalid parameter %q", param)
+	}
+	if n < p {
+		return fmt.Errorf("must be at least %s", param)
+	}
+	return nil
+}
+
+func max(i interface{}, param string) error {
+	n, ok := numericLen(i)
+	if !ok {
+		return fmt.Errorf("max: unsupported type %T", i)
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("max: invalid parameter %q", param)
+	}
+	if n > p {
+		return fmt.Errorf("must be at most %s", param)
+	}
+	return nil
+}
+
+func length(i interface{}, param string) error {
+	n, ok := numericLen(i)
+	if !ok {
+		return fmt.Errorf("len: unsupported type %T", i)
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("len: invalid parameter %q", param)
+	}
+	if n != p {
+		return fmt.Errorf("must have length %s", param)
+	}
+	return nil
+}
+
+func gt(i interface{}, param string) error {
+	n, ok := numericLen(i)
+	if !ok {
+		return fmt.Errorf("gt: unsupported type %T", i)
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("gt: invalid parameter %q", param)
+	}
+	if n <= p {
+		return fmt.Errorf("must be greater than %s", param)
+	}
+	return nil
+}
+
+func lt(i interface{}, param string) error {
+	n, ok := numericLen(i)
+	if !ok {
+		return fmt.Errorf("lt: unsupported type %T", i)
+	}
+	p, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return fmt.Errorf("lt: invalid parameter %q", param)
+	}
+	if n >= p {
+		return fmt.Errorf("must be less than %s", param)
+	}
+	return nil
+}
+
+func oneof(i interface{}, param string) error {
+	s := fmt.Sprintf("%v", i)
+	for _, opt := range strings.Fields(param) {
+		if s == opt {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of [%s]", param)
+}
+
+func required(i interface{}, param string) error {
+	if isNilRef(i) || reflect.ValueOf(i).IsZero() {
+		return fmt.Errorf("is required")
+	}
+	return nil
+}
+
+func regex(i interface{}, param string) error {
+	s, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("regex: unsupported type %T", i)
+	}
+	re, err := regexp.Compile(param)
+	if err != nil {
+		return fmt.Errorf("regex: invalid pattern %q", param)
+	}
+	if !re.MatchString(s) {
+		return fmt.Errorf("must match %q", param)
+	}
+	return nil
+}
+
+// fieldByName returns the value of the exported field named name on the
+// struct s (or the struct *s points to).
+func fieldByName(s interface{}, name string) (interface{}, error) {
+	v := reflect.ValueOf(s)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("eqfield: %T is not a struct", s)
+	}
+	fv := v.FieldByName(name)
+	if !fv.IsValid() || !fv.CanInterface() {
+		return nil, fmt.Errorf("eqfield: no such field %q", name)
+	}
+	return fv.Interface(), nil
+}
+
+func eqfield(field, parent, top interface{}, param string) error {
+	other, err := fieldByName(parent, param)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(field, other) {
+		return fmt.Errorf("must equal field %s", param)
+	}
+	return nil
+}
+
+func eqtopfield(field, parent, top interface{}, param string) error {
+	other, err := fieldByName(top, param)
+	if err != nil {
+		return err
+	}
+	if !reflect.DeepEqual(field, other) {
+		return fmt.Errorf("must equal field %s", param)
+	}
+	return nil
+}
+
+func gtefield(field, parent, top interface{}, param string) error {
+	other, err := fieldByName(parent, param)
+	if err != nil {
+		return err
+	}
+	fn, ok1 := numericLen(field)
+	on, ok2 := numericLen(other)
+	if !ok1 || !ok2 {
+		return fmt.Errorf("gtefield: unsupported type")
+	}
+	if fn < on {
+		return fmt.Errorf("must be greater than or equal to field %s", param)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package validate
+
+import "testing"
+
+// newFullV returns a V with every baked-in validator and cross-field
+// validator registered, for use across this package's tests.
+func newFullV() V {
+	vd := New()
+	for name, fn := range BakedIn {
+		vd.Funcs[name] = fn
+	}
+	for name, fn := range BakedInCross {
+		vd.Cross[name] = fn
+	}
+	return vd
+}
+
+func TestBakedInParameterized(t *testing.T) {
+	type X struct {
+		Name string `validate:"min=3,max=5"`
+		N    int    `validate:"gt=0,lt=10"`
+		Len  string `validate:"len=4"`
+		Pick string `validate:"oneof=red green blue"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Name: "abcd", N: 5, Len: "quad", Pick: "green"}); err != nil {
+		t.Fatalf("expected valid struct to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Name: "ab", N: 20, Len: "quad", Pick: "purple"})
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	ve, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T", err)
+	}
+	if len(ve) != 3 {
+		t.Fatalf("expected 3 field errors, got %d: %v", len(ve), ve)
+	}
+}
+
+func TestEscapedCommaAndEqualInParam(t *testing.T) {
+	type X struct {
+		// "0x2C" decodes to a literal comma, "0x3D" to a literal equals sign.
+		Pick string `validate:"oneof=a0x2Cb c0x3Dd"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Pick: "a,b"}); err != nil {
+		t.Fatalf("expected escaped comma alternative to match, got %v", err)
+	}
+	if err := vd.Validate(&X{Pick: "c=d"}); err != nil {
+		t.Fatalf("expected escaped equals alternative to match, got %v", err)
+	}
+	if err := vd.Validate(&X{Pick: "a"}); err == nil {
+		t.Fatal("expected unescaped \"a\" to fail to match \"a,b\"")
+	}
+}
+
+func TestRequired(t *testing.T) {
+	type X struct {
+		Name string `validate:"required"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Name: "set"}); err != nil {
+		t.Fatalf("expected non-zero value to pass, got %v", err)
+	}
+	if err := vd.Validate(&X{}); err == nil {
+		t.Fatal("expected zero value to fail required")
+	}
+}
+
+func TestRequiredOnNilInterfaceDoesNotPanic(t *testing.T) {
+	type X struct {
+		Data interface{} `validate:"required"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err == nil {
+		t.Fatal("expected a nil interface{} to fail required")
+	}
+	if err := vd.Validate(&X{Data: "set"}); err != nil {
+		t.Fatalf("expected a non-nil interface{} to pass, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package validate
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// tagEntry is a single, already-parsed entry from a "validate" tag, such as
+// "min=3", "struct", "dive" or the OR-group "email|url".
+type tagEntry struct {
+	raw   string // the original entry, e.g. "min=3" or "email|url"
+	name  string // validator name; unused for "struct"/"dive"/OR-groups
+	param string
+	alts  []tagEntry // the alternatives of an OR-group; nil otherwise
+}
+
+// fieldPlan is the precomputed validation plan for a single tagged struct
+// field.
+type fieldPlan struct {
+	index int
+	name  string
+	tags  []tagEntry
+}
+
+// structPlan is the precomputed validation plan for a struct type: one
+// fieldPlan per tagged field that is exported or embedded, in field order.
+// An unexported embedded field is kept here and skipped by validate, which
+// checks CanInterface on each field.
+type structPlan []fieldPlan
+
+// planCache memoizes structPlan by reflect.Type, since a type's tags never
+// change between calls to Validate.
+var planCache sync.Map // reflect.Type -> structPlan
+
+// plan returns the structPlan for t, building and caching it on first use.
+func plan(t reflect.Type) structPlan {
+	if p, ok := planCache.Load(t); ok {
+		return p.(structPlan)
+	}
+
+	var p structPlan
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported fields can never be interfaced
+		}
+		tag := f.Tag.Get("validate")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		p = append(p, fieldPlan{
+			index: i,
+			name:  f.Name,
+			tags:  parseTags(tag),
+		})
+	}
+
+	actual, _ := planCache.LoadOrStore(t, p)
+	return actual.(structPlan)
+}
+
+// parseTags splits a "validate" tag into its comma-separated entries and
+// parses each one.
+func parseTags(tag string) []tagEntry {
+	parts := strings.Split(tag, ",")
+	entries := make([]tagEntry, len(parts))
+	for i, part := range parts {
+		entries[i] = parseTagEntry(part)
+	}
+	return entries
+}
+
+// parseTagEntry parses a single comma-separated tag entry.
+func parseTagEntry(raw string) tagEntry {
+	switch raw {
+	case "struct", "dive", "keys", "endkeys", "omitempty":
+		return tagEntry{raw: raw}
+	}
+
+	if strings.Contains(raw, "|") {
+		parts := strings.Split(raw, "|")
+		alts := make([]tagEntry, len(parts))
+		for i, p := range parts {
+			alts[i] = parseTagEntry(p)
+		}
+		return tagEntry{raw: raw, alts: alts}
+	}
+
+	name, param := splitTag(raw)
+	return tagEntry{raw: raw, name: name, param: param}
+}
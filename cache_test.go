@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPlanIsCachedAcrossCalls(t *testing.T) {
+	type X struct {
+		Name string `validate:"min=3"`
+	}
+
+	planCache.Delete(reflect.TypeOf(X{}))
+
+	first := plan(reflect.TypeOf(X{}))
+	second := plan(reflect.TypeOf(X{}))
+
+	if len(first) != 1 || first[0].name != "Name" {
+		t.Fatalf("unexpected plan: %v", first)
+	}
+	if &first[0] != &second[0] {
+		t.Fatal("expected the second plan call to reuse the cached structPlan's backing array")
+	}
+}
+
+func TestRepeatedValidateCallsUseConsistentPlan(t *testing.T) {
+	type X struct {
+		Name string `validate:"min=3"`
+	}
+
+	vd := newFullV()
+
+	for i := 0; i < 3; i++ {
+		err := vd.Validate(&X{Name: "ab"})
+		if err == nil {
+			t.Fatalf("call %d: expected a validation error", i)
+		}
+		ve := err.(ValidationErrors)
+		if len(ve) != 1 || ve[0].Field != "Name" {
+			t.Fatalf("call %d: unexpected errors: %v", i, ve)
+		}
+	}
+
+	if err := vd.Validate(&X{Name: "abc"}); err != nil {
+		t.Fatalf("expected a valid value to still pass after repeated calls, got %v", err)
+	}
+}
+
+func TestPlanDoesNotConflateDistinctTypes(t *testing.T) {
+	type A struct {
+		Name string `validate:"min=3"`
+	}
+	type B struct {
+		Name string `validate:"min=10"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&A{Name: "abc"}); err != nil {
+		t.Fatalf("expected A to pass its own plan, got %v", err)
+	}
+	if err := vd.Validate(&B{Name: "abc"}); err == nil {
+		t.Fatal("expected B to fail its own, stricter plan")
+	}
+}
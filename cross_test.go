@@ -0,0 +1,60 @@
+package validate
+
+import "testing"
+
+func TestCrossFieldAgainstParent(t *testing.T) {
+	type Creds struct {
+		Password        string
+		ConfirmPassword string `validate:"eqfield=Password"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&Creds{Password: "hunter2", ConfirmPassword: "hunter2"}); err != nil {
+		t.Fatalf("expected matching passwords to pass, got %v", err)
+	}
+	if err := vd.Validate(&Creds{Password: "hunter2", ConfirmPassword: "other"}); err == nil {
+		t.Fatal("expected mismatched passwords to fail")
+	}
+}
+
+func TestCrossFieldAgainstTopLevel(t *testing.T) {
+	type Address struct {
+		OwnerID string `validate:"eqtopfield=ID"`
+	}
+	type User struct {
+		ID      string
+		Address Address `validate:"struct"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&User{ID: "u1", Address: Address{OwnerID: "u1"}}); err != nil {
+		t.Fatalf("expected matching top-level ID to pass, got %v", err)
+	}
+
+	err := vd.Validate(&User{ID: "u1", Address: Address{OwnerID: "u2"}})
+	if err == nil {
+		t.Fatal("expected mismatched top-level ID to fail")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Namespace != "Address.OwnerID" {
+		t.Fatalf("expected a single error on Address.OwnerID, got %v", ve)
+	}
+}
+
+func TestGtefield(t *testing.T) {
+	type Range struct {
+		Min int
+		Max int `validate:"gtefield=Min"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&Range{Min: 1, Max: 1}); err != nil {
+		t.Fatalf("expected equal bounds to pass, got %v", err)
+	}
+	if err := vd.Validate(&Range{Min: 5, Max: 1}); err == nil {
+		t.Fatal("expected Max below Min to fail")
+	}
+}
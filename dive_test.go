@@ -0,0 +1,108 @@
+package validate
+
+import "testing"
+
+func TestDiveOverSlice(t *testing.T) {
+	type X struct {
+		Tags []string `validate:"dive,min=3"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Tags: []string{"abc", "abcd"}}); err != nil {
+		t.Fatalf("expected all elements to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Tags: []string{"abc", "ab"}})
+	if err == nil {
+		t.Fatal("expected an error for the short element")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Field != "Tags[1]" {
+		t.Fatalf("expected a single error on Tags[1], got %v", ve)
+	}
+}
+
+func TestDiveOverArray(t *testing.T) {
+	type X struct {
+		Scores [3]int `validate:"dive,gt=0"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Scores: [3]int{1, 2, 3}}); err != nil {
+		t.Fatalf("expected all elements to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Scores: [3]int{1, 0, 3}})
+	if err == nil {
+		t.Fatal("expected an error for the zero element")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Field != "Scores[1]" {
+		t.Fatalf("expected a single error on Scores[1], got %v", ve)
+	}
+}
+
+func TestDiveOverMapValues(t *testing.T) {
+	type X struct {
+		Ages map[string]int `validate:"dive,gt=0"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Ages: map[string]int{"a": 1}}); err != nil {
+		t.Fatalf("expected value to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Ages: map[string]int{"a": 0}})
+	if err == nil {
+		t.Fatal("expected an error for the zero value")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Field != "Ages[a]" {
+		t.Fatalf("expected a single error on Ages[a], got %v", ve)
+	}
+}
+
+func TestNestedDiveOverSliceOfSlices(t *testing.T) {
+	type X struct {
+		Rows [][]string `validate:"dive,dive,min=3"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Rows: [][]string{{"abc", "abcd"}, {"xyz"}}}); err != nil {
+		t.Fatalf("expected all elements to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Rows: [][]string{{"abc", "ab"}, {"xyz"}}})
+	if err == nil {
+		t.Fatal("expected an error for the short nested element")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Field != "Rows[0][1]" {
+		t.Fatalf("expected a single error on Rows[0][1], got %v", ve)
+	}
+}
+
+func TestDiveOverMapKeysAndValues(t *testing.T) {
+	type X struct {
+		Ages map[string]int `validate:"dive,keys,min=3,endkeys,gt=0"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Ages: map[string]int{"abc": 1}}); err != nil {
+		t.Fatalf("expected key and value to pass, got %v", err)
+	}
+
+	err := vd.Validate(&X{Ages: map[string]int{"ab": 1}})
+	if err == nil {
+		t.Fatal("expected an error for the short key")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Field != "Ages[ab].key" {
+		t.Fatalf("expected a single error on Ages[ab].key, got %v", ve)
+	}
+}
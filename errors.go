@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldError describes a single failed validator on a single struct field.
+type FieldError struct {
+	// Field is the name of the field that failed, e.g. "Street" or, inside
+	// a dive, "Tags[0]".
+	Field string
+	// Namespace is the full dotted path from the struct passed to
+	// Validate, starting at one of its fields, e.g. "Address.Street" for a
+	// User passed to Validate.
+	Namespace string
+	// Tag is the validator that failed, e.g. "min". For an OR-group it is
+	// the whole group, e.g. "email|url".
+	Tag string
+	// Param is the parameter given to Tag, if any, e.g. "3" for "min=3".
+	Param string
+	// Value is the field's actual value.
+	Value interface{}
+	// Kind is the reflect.Kind of Value.
+	Kind reflect.Kind
+	// Err is the error returned by the validator.
+	Err error
+}
+
+// Error implements the error interface.
+func (fe *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", fe.Namespace, fe.Err)
+}
+
+// Unwrap returns the error returned by the validator, so that errors.Is and
+// errors.As can see through a FieldError to it.
+func (fe *FieldError) Unwrap() error {
+	return fe.Err
+}
+
+// Translate renders fe as a human-readable message using t, falling back to
+// fe.Error() if t has no template registered for fe.Tag.
+func (fe *FieldError) Translate(t *Translator) string {
+	return t.Translate(fe)
+}
+
+// ValidationErrors is the list of FieldErrors produced by a failed Validate
+// call. It implements error, so callers can return v.Validate(x) directly.
+type ValidationErrors []*FieldError
+
+// Error implements the error interface, joining every field error with "; ".
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, len(ve))
+	for i, fe := range ve {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes the individual FieldErrors to errors.Is and errors.As.
+func (ve ValidationErrors) Unwrap() []error {
+	errs := make([]error, len(ve))
+	for i, fe := range ve {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// Translate renders every FieldError in ve using t, keyed by namespace.
+func (ve ValidationErrors) Translate(t *Translator) map[string]string {
+	out := make(map[string]string, len(ve))
+	for _, fe := range ve {
+		out[fe.Namespace] = fe.Translate(t)
+	}
+	return out
+}
+
+// add appends a FieldError built from the given components to errs.
+func (errs *ValidationErrors) add(field, namespace, tag, param string, val interface{}, err error) {
+	*errs = append(*errs, &FieldError{
+		Field:     field,
+		Namespace: namespace,
+		Tag:       tag,
+		Param:     param,
+		Value:     val,
+		Kind:      reflect.ValueOf(val).Kind(),
+		Err:       err,
+	})
+}
@@ -0,0 +1,66 @@
+package validate
+
+import "testing"
+
+func TestNamespaceForNestedStruct(t *testing.T) {
+	type Address struct {
+		Street string `validate:"required"`
+	}
+	type User struct {
+		Address Address `validate:"struct"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&User{})
+	if err == nil {
+		t.Fatal("expected an error for the empty nested street")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Namespace != "Address.Street" {
+		t.Fatalf("expected namespace %q, got %v", "Address.Street", ve)
+	}
+}
+
+func TestNamespaceForDeeplyNestedStruct(t *testing.T) {
+	type City struct {
+		Name string `validate:"required"`
+	}
+	type Address struct {
+		City City `validate:"struct"`
+	}
+	type User struct {
+		Address Address `validate:"struct"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&User{})
+	if err == nil {
+		t.Fatal("expected an error for the empty city name")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Namespace != "Address.City.Name" {
+		t.Fatalf("expected namespace %q, got %v", "Address.City.Name", ve)
+	}
+}
+
+func TestNamespaceForDiveOverStructSlice(t *testing.T) {
+	type Item struct {
+		SKU string `validate:"required"`
+	}
+	type Order struct {
+		Items []Item `validate:"dive,struct"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&Order{Items: []Item{{SKU: "abc"}, {}}})
+	if err == nil {
+		t.Fatal("expected an error for the second item's empty SKU")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Namespace != "Items[1].SKU" {
+		t.Fatalf("expected namespace %q, got %v", "Items[1].SKU", ve)
+	}
+}
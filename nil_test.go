@@ -0,0 +1,105 @@
+package validate
+
+import "testing"
+
+func TestNilStructPointerIsSkippedCleanly(t *testing.T) {
+	type Sub struct {
+		Name string `validate:"required"`
+	}
+	type X struct {
+		Sub *Sub `validate:"struct"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err != nil {
+		t.Fatalf("expected a nil *Sub to be skipped, got %v", err)
+	}
+}
+
+func TestNilInterfaceFieldIsSkippedCleanly(t *testing.T) {
+	type X struct {
+		Sub interface{} `validate:"struct"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err != nil {
+		t.Fatalf("expected a nil interface{} to be skipped without panicking, got %v", err)
+	}
+}
+
+func TestStructThenRequiredRejectsNil(t *testing.T) {
+	type Sub struct{}
+	type X struct {
+		Sub *Sub `validate:"struct,required"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&X{})
+	if err == nil {
+		t.Fatal("expected nil Sub to fail required")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Tag != "required" {
+		t.Fatalf("expected a single required error, got %v", ve)
+	}
+}
+
+func TestRequiredThenStructRejectsNil(t *testing.T) {
+	type Sub struct{}
+	type X struct {
+		Sub *Sub `validate:"required,struct"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&X{})
+	if err == nil {
+		t.Fatal("expected nil Sub to fail required")
+	}
+	ve := err.(ValidationErrors)
+	if len(ve) != 1 || ve[0].Tag != "required" {
+		t.Fatalf("expected a single required error, got %v", ve)
+	}
+}
+
+func TestOmitemptySkipsRemainingValidators(t *testing.T) {
+	type X struct {
+		Name string `validate:"omitempty,min=3"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err != nil {
+		t.Fatalf("expected an empty value to skip min, got %v", err)
+	}
+	if err := vd.Validate(&X{Name: "ab"}); err == nil {
+		t.Fatal("expected a non-empty short value to still fail min")
+	}
+}
+
+func TestOmitemptyOnNilInterfaceIsSkippedWithoutPanicking(t *testing.T) {
+	type X struct {
+		Data interface{} `validate:"omitempty,min=3"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err != nil {
+		t.Fatalf("expected a nil interface{} to skip min without panicking, got %v", err)
+	}
+}
+
+func TestDashTagSkipsFieldEntirely(t *testing.T) {
+	type X struct {
+		Name string `validate:"-"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{}); err != nil {
+		t.Fatalf("expected a \"-\" tagged field to never be validated, got %v", err)
+	}
+}
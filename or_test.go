@@ -0,0 +1,58 @@
+package validate
+
+import "testing"
+
+func TestOrGroupPassesOnAnyAlternative(t *testing.T) {
+	type X struct {
+		Contact string `validate:"len=5|len=10"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Contact: "abcde"}); err != nil {
+		t.Fatalf("expected first alternative to pass, got %v", err)
+	}
+	if err := vd.Validate(&X{Contact: "abcdefghij"}); err != nil {
+		t.Fatalf("expected second alternative to pass, got %v", err)
+	}
+}
+
+func TestOrGroupFailsWhenNoAlternativePasses(t *testing.T) {
+	type X struct {
+		Contact string `validate:"len=5|len=10"`
+	}
+
+	vd := newFullV()
+
+	err := vd.Validate(&X{Contact: "ab"})
+	if err == nil {
+		t.Fatal("expected an error when no alternative passes")
+	}
+	ve, ok := err.(ValidationErrors)
+	if !ok || len(ve) != 1 {
+		t.Fatalf("expected a single ValidationErrors entry, got %v", err)
+	}
+	if ve[0].Tag != "len=5|len=10" {
+		t.Fatalf("expected the FieldError to report the whole OR-group, got %q", ve[0].Tag)
+	}
+}
+
+func TestOrGroupWithEscapedPipeInRegex(t *testing.T) {
+	type X struct {
+		// 0x7C decodes to a literal "|", so this matches "cat" or "dog"
+		// via the regex's own alternation rather than the tag's.
+		Animal string `validate:"regex=^(cat0x7Cdog)$"`
+	}
+
+	vd := newFullV()
+
+	if err := vd.Validate(&X{Animal: "cat"}); err != nil {
+		t.Fatalf("expected \"cat\" to match the regex alternation, got %v", err)
+	}
+	if err := vd.Validate(&X{Animal: "dog"}); err != nil {
+		t.Fatalf("expected \"dog\" to match the regex alternation, got %v", err)
+	}
+	if err := vd.Validate(&X{Animal: "fish"}); err == nil {
+		t.Fatal("expected \"fish\" to fail to match the regex alternation")
+	}
+}
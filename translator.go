@@ -0,0 +1,71 @@
+package validate
+
+import "strings"
+
+// Translator renders FieldErrors as human-readable messages using per-tag
+// templates, optionally grouped by locale. A template may use "{0}" for the
+// field's name and "{1}" for the tag's parameter, e.g.
+//
+//	t := validate.NewTranslator("en")
+//	t.Add("min", "{0} must be at least {1} characters")
+type Translator struct {
+	locale  string
+	locales map[string]map[string]string
+}
+
+// NewTranslator returns a Translator whose default locale is locale.
+func NewTranslator(locale string) *Translator {
+	return &Translator{
+		locale:  locale,
+		locales: map[string]map[string]string{locale: {}},
+	}
+}
+
+// Add registers a message template for tag in the translator's default
+// locale.
+func (t *Translator) Add(tag, template string) {
+	t.AddLocale(t.locale, tag, template)
+}
+
+// AddLocale registers a message template for tag in the given locale.
+func (t *Translator) AddLocale(locale, tag, template string) {
+	m, ok := t.locales[locale]
+	if !ok {
+		m = make(map[string]string)
+		t.locales[locale] = m
+	}
+	m[tag] = template
+}
+
+// Translate renders fe using the translator's default locale.
+func (t *Translator) Translate(fe *FieldError) string {
+	return t.TranslateLocale(t.locale, fe)
+}
+
+// TranslateLocale renders fe using the given locale, falling back to the
+// default locale and then to fe.Error() if no template is registered.
+func (t *Translator) TranslateLocale(locale string, fe *FieldError) string {
+	if tmpl, ok := t.template(locale, fe.Tag); ok {
+		return expandTemplate(tmpl, fe)
+	}
+	if locale != t.locale {
+		if tmpl, ok := t.template(t.locale, fe.Tag); ok {
+			return expandTemplate(tmpl, fe)
+		}
+	}
+	return fe.Error()
+}
+
+func (t *Translator) template(locale, tag string) (string, bool) {
+	m, ok := t.locales[locale]
+	if !ok {
+		return "", false
+	}
+	tmpl, ok := m[tag]
+	return tmpl, ok
+}
+
+func expandTemplate(tmpl string, fe *FieldError) string {
+	r := strings.NewReplacer("{0}", fe.Field, "{1}", fe.Param)
+	return r.Replace(tmpl)
+}
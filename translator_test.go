@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTranslatorRendersRegisteredTemplate(t *testing.T) {
+	tr := NewTranslator("en")
+	tr.Add("min", "{0} must be at least {1}")
+
+	fe := &FieldError{Field: "Name", Namespace: "Name", Tag: "min", Param: "3"}
+
+	if got, want := fe.Translate(tr), "Name must be at least 3"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestTranslatorFallsBackToFieldErrorWhenTagUnregistered(t *testing.T) {
+	tr := NewTranslator("en")
+
+	fe := &FieldError{Namespace: "Name", Tag: "min", Param: "3", Err: errors.New("is required")}
+
+	if got, want := fe.Translate(tr), fe.Error(); got != want {
+		t.Fatalf("got %q, want fallback %q", got, want)
+	}
+}
+
+func TestTranslatorFallsBackToDefaultLocale(t *testing.T) {
+	tr := NewTranslator("en")
+	tr.Add("min", "{0} must be at least {1}")
+
+	fe := &FieldError{Field: "Name", Namespace: "Name", Tag: "min", Param: "3"}
+
+	if got, want := tr.TranslateLocale("fr", fe), "Name must be at least 3"; got != want {
+		t.Fatalf("expected fallback to the default locale's template, got %q want %q", got, want)
+	}
+}
+
+func TestTranslatorLocaleOverridesDefault(t *testing.T) {
+	tr := NewTranslator("en")
+	tr.Add("min", "{0} must be at least {1}")
+	tr.AddLocale("fr", "min", "{0} doit être au moins {1}")
+
+	fe := &FieldError{Field: "Nom", Namespace: "Nom", Tag: "min", Param: "3"}
+
+	if got, want := tr.TranslateLocale("fr", fe), "Nom doit être au moins 3"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestRegisterDefaultTranslations(t *testing.T) {
+	tr := NewTranslator("en")
+	RegisterDefaultTranslations(tr)
+
+	fe := &FieldError{Field: "Name", Namespace: "Name", Tag: "required"}
+
+	if got, want := fe.Translate(tr), "Name is required"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
@@ -14,23 +14,59 @@ For example:
 	}
 
 Multiple validators can be named in the tag by separating their names with commas.
-The validators are defined in a map like so:
+The validators are defined in a V like so:
 
-	vd := make(validate.V)
-	vd["long"] = func(i interface{}) error {
+	vd := validate.New()
+	vd.Funcs["long"] = func(i interface{}, param string) error {
 		…
 	}
-	vd["short"] = func(i interface{}) error {
+	vd.Funcs["short"] = func(i interface{}, param string) error {
 		…
 	}
 	…
 
-When present in a field's tag, the Validate method passes to these functions the value in the field
-and should return an error when the value is deemed invalid.
+When present in a field's tag, the Validate method passes to these functions the value in the field,
+along with any parameter given after an "=" in the tag entry (e.g. `validate:"min=3"` passes "3" as
+the parameter to "min"), and should return an error when the value is deemed invalid. A validator
+that never takes a parameter may simply ignore the argument. A literal comma, equals sign or pipe
+inside a parameter can be written as 0x2C, 0x3D or 0x7C respectively, since those characters
+otherwise delimit the tag itself; see baked_in.go for examples such as "regex".
 
 There is a reserved tag, "struct", which can be used to automatically validate a
 struct field, either named or embedded. This may be combined with user-defined validators.
 
+A tag entry may also list several validators separated by "|", meaning that the field is
+valid if any one of them passes, e.g. `validate:"email|url"`. If every alternative fails,
+the reported error aggregates each alternative's failure. A parameter that needs a literal "|",
+such as a regex with alternation, must escape it as 0x7C, e.g. `validate:"regex=^(cat0x7Cdog)$"`.
+
+Another reserved token, "dive", applies the validators that follow it to each element of a
+slice, array or map field instead of to the field itself, e.g. `validate:"min=1,dive,required"`
+requires the field to have at least one element and every element to be non-zero. For a map,
+the tags between "keys" and "endkeys" are applied to each key instead of each value:
+`validate:"dive,keys,required,endkeys,min=3"`. Failures are reported under the field's name
+with an index or key appended, such as "Field[0]" or "Field[key]". Dives may be nested for
+multi-dimensional slices and maps.
+
+Cross-field validators, registered in vd.Cross, additionally receive the struct enclosing the
+field (parent) and the original struct passed to Validate (top), which enables rules like
+"ConfirmPassword must equal Password" (`validate:"eqfield=Password"`) or comparisons against a
+field of an ancestor struct (`validate:"eqtopfield=ID"`).
+
+Validate returns a ValidationErrors, one FieldError per failed validator, each carrying the
+field's name, its full dotted namespace from the top-level struct (e.g. "Address.Street" when a
+User is passed to Validate),
+the tag and parameter that failed, the field's value and kind, and the underlying error.
+ValidationErrors implements error, and its FieldErrors can be reached with errors.Is/errors.As.
+
+A tag of exactly "-" skips the field entirely, which is useful when a struct embeds a
+third-party type whose fields should not be validated. The token "omitempty" skips the
+remaining validators in the entry for a field whose value is the zero value for its type,
+e.g. `validate:"omitempty,min=3"` only checks min when the field is non-empty. A "struct" tag
+on a nil pointer or nil interface field is skipped cleanly rather than recursed into, and any
+validator listed later in the same entry (such as "required") still runs, so
+`validate:"struct,required"` and `validate:"required,struct"` both reject a nil value.
+
 Reflection is used to access the tags and fields, so the usual caveats and limitations apply.
 */
 package validate
@@ -41,25 +77,62 @@ import (
 	"strings"
 )
 
-// V is a map of tag names to validators.
-type V map[string]func(interface{}) error
+// escapedComma, escapedEqual and escapedPipe stand in for a literal ",", "="
+// or "|" inside a validator's parameter, since those characters otherwise
+// delimit the tag.
+const (
+	escapedComma = "0x2C"
+	escapedEqual = "0x3D"
+	escapedPipe  = "0x7C"
+)
+
+// Func validates a single field's value against an optional parameter.
+type Func func(field interface{}, param string) error
+
+// CrossFunc validates a field's value like Func, but also receives parent,
+// the struct that directly encloses the field, and top, the original
+// struct passed to Validate. This allows rules that compare one field
+// against another, such as "must equal sibling field X".
+type CrossFunc func(field interface{}, parent interface{}, top interface{}, param string) error
 
-// Validate accepts a struct (or a pointer) and returns a list of errors for all
-// fields that are invalid. If all fields are valid, or s is not a struct type,
-// Validate returns nil.
+// V holds the validators available to Validate: Funcs for validators that
+// only need the field's own value, and Cross for validators that also need
+// the enclosing (or top-level) struct. Use New to construct one with both
+// maps initialized.
+type V struct {
+	Funcs map[string]Func
+	Cross map[string]CrossFunc
+}
+
+// New returns a V with empty Funcs and Cross maps, ready for validators to
+// be registered.
+func New() V {
+	return V{
+		Funcs: make(map[string]Func),
+		Cross: make(map[string]CrossFunc),
+	}
+}
+
+// Validate accepts a struct (or a pointer) and returns a ValidationErrors for
+// all fields that are invalid, or nil if all fields are valid or s is not a
+// struct type.
 //
 // Fields that are not tagged or cannot be interfaced via reflection
 // are skipped.
-func (v V) Validate(s interface{}) map[string]interface{} {
-	errors := make(map[string]interface{})
-	v.validate(errors, s)
-	if len(errors) > 0 {
-		return errors
+func (v V) Validate(s interface{}) error {
+	var errs ValidationErrors
+	v.validate(&errs, s, s, "")
+	if len(errs) > 0 {
+		return errs
 	}
 	return nil
 }
 
-func (v V) validate(errs map[string]interface{}, s interface{}) {
+// validate validates the fields of s, appending any failures to errs. top is
+// the struct originally passed to Validate, available throughout the
+// recursion to "eqtopfield"-style cross-field validators. namespace is the
+// dotted path from top to s.
+func (v V) validate(errs *ValidationErrors, s interface{}, top interface{}, namespace string) {
 	val := reflect.ValueOf(s)
 
 	if val.Kind() == reflect.Ptr {
@@ -70,40 +143,169 @@ func (v V) validate(errs map[string]interface{}, s interface{}) {
 	if t == nil || t.Kind() != reflect.Struct {
 		return
 	}
+	parent := val.Interface()
 
-	for i := 0; i < t.NumField(); i++ {
-		f := t.Field(i)
-		fv := val.Field(i)
+	for _, fp := range plan(t) {
+		fv := val.Field(fp.index)
 		if !fv.CanInterface() {
 			continue
 		}
-		val := fv.Interface()
-		tag := f.Tag.Get("validate")
-		if tag == "" {
-			continue
+		ns := fp.name
+		if namespace != "" {
+			ns = namespace + "." + fp.name
 		}
-		vts := strings.Split(tag, ",")
-
-		for _, vt := range vts {
-			if vt == "struct" {
-				errs2 := v.Validate(val)
-				if errs2 != nil {
-					/* A field validation has failed */
-					errs[f.Name] = errs2
-					break
-				}
+		v.applyTags(errs, fp.name, ns, fv.Interface(), parent, top, fp.tags)
+	}
+}
+
+// applyTags runs the parsed validator entries in tags against val, recording
+// the first failure (field/namespace identify it) in errs. It is used both
+// for a struct field directly and, via dive, for each element of a slice,
+// array or map field. parent and top are forwarded to any cross-field
+// validators.
+func (v V) applyTags(errs *ValidationErrors, field, namespace string, val, parent, top interface{}, tags []tagEntry) {
+	for i, te := range tags {
+		switch te.raw {
+		case "struct":
+			if isNilRef(val) {
 				continue
 			}
+			n := len(*errs)
+			v.validate(errs, val, top, namespace)
+			if len(*errs) > n {
+				return
+			}
+			continue
+		case "dive":
+			v.dive(errs, field, namespace, val, parent, top, tags[i+1:])
+			return
+		case "omitempty":
+			if isNilRef(val) || reflect.ValueOf(val).IsZero() {
+				return
+			}
+			continue
+		}
 
-			vf := v[vt]
-			if vf == nil {
-				errs[f.Name] = fmt.Errorf("undefined validator: %q", vt)
-				break
+		if te.alts != nil {
+			if err := v.validateOr(val, parent, top, te); err != nil {
+				errs.add(field, namespace, te.raw, "", val, err)
+				return
 			}
-			if err := vf(val); err != nil {
-				errs[f.Name] = err
-				break
+			continue
+		}
+
+		if err := v.call(val, parent, top, te.name, te.param); err != nil {
+			errs.add(field, namespace, te.name, te.param, val, err)
+			return
+		}
+	}
+}
+
+// isNilRef reports whether val is a nil pointer or nil interface, so a
+// "struct" tag can skip it instead of panicking when it dereferences. A nil
+// interface{} field passed through fv.Interface() carries no type
+// information, so reflect.ValueOf(val) yields the invalid zero Value rather
+// than a Kind of Interface; that case is nil too.
+func isNilRef(val interface{}) bool {
+	rv := reflect.ValueOf(val)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	}
+	return false
+}
+
+// call runs the validator name (e.g. "min", with param "3") against val,
+// looking it up in Funcs first and then Cross.
+func (v V) call(val, parent, top interface{}, name, param string) error {
+	if vf, ok := v.Funcs[name]; ok {
+		return vf(val, param)
+	}
+	if cf, ok := v.Cross[name]; ok {
+		return cf(val, parent, top, param)
+	}
+	return fmt.Errorf("undefined validator: %q", name)
+}
+
+// dive applies tags, the validators that followed a "dive" token, to each
+// element of val, which must be a slice, array or map. For a map, the tags
+// between "keys" and "endkeys" (if present) apply to each key instead of
+// each value. Failures are recorded with an "[index]" or "[key]" suffix on
+// both field and namespace, and failures of a key's own tags with
+// "[key].key".
+func (v V) dive(errs *ValidationErrors, field, namespace string, val, parent, top interface{}, tags []tagEntry) {
+	rv := reflect.ValueOf(val)
+
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			ev := rv.Index(i)
+			if !ev.CanInterface() {
+				continue
+			}
+			v.applyTags(errs, fmt.Sprintf("%s[%d]", field, i), fmt.Sprintf("%s[%d]", namespace, i), ev.Interface(), parent, top, tags)
+		}
+	case reflect.Map:
+		keyTags, valTags := splitMapTags(tags)
+		for _, mk := range rv.MapKeys() {
+			ks := fmt.Sprintf("%v", mk.Interface())
+			if keyTags != nil {
+				v.applyTags(errs, fmt.Sprintf("%s[%s].key", field, ks), fmt.Sprintf("%s[%s].key", namespace, ks), mk.Interface(), parent, top, keyTags)
 			}
+			ev := rv.MapIndex(mk)
+			if !ev.CanInterface() {
+				continue
+			}
+			v.applyTags(errs, fmt.Sprintf("%s[%s]", field, ks), fmt.Sprintf("%s[%s]", namespace, ks), ev.Interface(), parent, top, valTags)
+		}
+	default:
+		errs.add(field, namespace, "dive", "", val, fmt.Errorf("dive: %T is not a slice, array or map", val))
+	}
+}
+
+// splitMapTags splits the tags following a "dive" on a map field into the
+// tags that apply to each key (between "keys" and "endkeys") and the tags
+// that apply to each value (everything else). keyTags is nil when the
+// "keys" token is absent.
+func splitMapTags(tags []tagEntry) (keyTags, valTags []tagEntry) {
+	if len(tags) == 0 || tags[0].raw != "keys" {
+		return nil, tags
+	}
+	for i := 1; i < len(tags); i++ {
+		if tags[i].raw == "endkeys" {
+			return tags[1:i], tags[i+1:]
+		}
+	}
+	return tags[1:], nil
+}
+
+// validateOr runs each alternative of the OR-group te against val and
+// succeeds as soon as one of them passes. If none does, it returns an error
+// aggregating every alternative's failure.
+func (v V) validateOr(val, parent, top interface{}, te tagEntry) error {
+	failures := make([]string, 0, len(te.alts))
+
+	for _, alt := range te.alts {
+		if err := v.call(val, parent, top, alt.name, alt.param); err != nil {
+			failures = append(failures, fmt.Sprintf("%q: %v", alt.raw, err))
+			continue
 		}
+		return nil
+	}
+
+	return fmt.Errorf("none of %q passed: %s", te.raw, strings.Join(failures, "; "))
+}
+
+// splitTag splits a single validator entry, such as "min=3" or "required",
+// into its name and parameter, unescaping any 0x2C/0x3D/0x7C sequences in
+// the parameter back into literal commas, equals signs and pipes.
+func splitTag(vt string) (name, param string) {
+	name = vt
+	if i := strings.Index(vt, "="); i >= 0 {
+		name, param = vt[:i], vt[i+1:]
 	}
+	param = strings.NewReplacer(escapedComma, ",", escapedEqual, "=", escapedPipe, "|").Replace(param)
+	return name, param
 }